@@ -0,0 +1,299 @@
+package rm1
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+)
+
+// Sample is a real test-day measurement used to calibrate a UserFormula:
+// a known weight and rep count paired with the lifter's actual one-rep max.
+type Sample struct {
+	Weight    float64
+	Reps      float64
+	Actual1RM float64
+}
+
+// Estimator is satisfied by any formula, built-in or calibrated, that can
+// estimate a one-rep max and predict reps at a given load.
+type Estimator interface {
+	Estimate(weight, reps float64) float64
+	PredictReps(rm1, weight float64) float64
+}
+
+// Estimate returns the one-rep max estimated by the built-in formula f.
+func (f Rm1Formula) Estimate(weight, reps float64) float64 {
+	return Rm1(weight, reps, f)
+}
+
+// PredictReps returns the reps predicted by the built-in formula f.
+func (f Rm1Formula) PredictReps(rm1, weight float64) float64 {
+	return RepPredict(rm1, weight, f)
+}
+
+// UserFormula is an Epley-shaped model, 1RM = weight * (a + b*reps),
+// fitted to a lifter's own Samples via Calibrate.
+type UserFormula struct {
+	A, B float64
+
+	samples []Sample
+}
+
+// Estimate returns the one-rep max estimated by the calibrated model.
+func (f UserFormula) Estimate(weight, reps float64) float64 {
+	return weight * (f.A + f.B*reps)
+}
+
+// PredictReps inverts the calibrated model to estimate the reps performable
+// at the given weight for a lifter with the given 1RM.
+func (f UserFormula) PredictReps(rm1, weight float64) float64 {
+	if f.B == 0 || weight == 0 {
+		return 0
+	}
+	return (rm1/weight - f.A) / f.B
+}
+
+// GoodnessOfFit returns the coefficient of determination (R^2) and the
+// residual standard deviation of the calibrated model against the samples
+// it was fitted on.
+func (f UserFormula) GoodnessOfFit() (rSquared, residualStdDev float64) {
+	if len(f.samples) == 0 {
+		return 0, 0
+	}
+	var actual []float64
+	var residuals []float64
+	for _, s := range f.samples {
+		predicted := f.Estimate(s.Weight, s.Reps)
+		residuals = append(residuals, s.Actual1RM-predicted)
+		actual = append(actual, s.Actual1RM)
+	}
+	actualMean := mean(actual)
+	var ssRes, ssTot float64
+	for i, a := range actual {
+		ssRes += residuals[i] * residuals[i]
+		d := a - actualMean
+		ssTot += d * d
+	}
+	if ssTot == 0 {
+		rSquared = 1
+	} else {
+		rSquared = 1 - ssRes/ssTot
+	}
+	return rSquared, stdDev(residuals)
+}
+
+// CalibrateMethod selects the fitting strategy used by Calibrate.
+type CalibrateMethod int
+
+const (
+	// LinearRegression solves for (a, b) in closed form. Accurate and fast,
+	// but sensitive to noisy or very small sample sets.
+	LinearRegression CalibrateMethod = iota
+
+	// CrossEntropy fits (a, b) with an evolution-strategy search, which is
+	// more robust on small or noisy datasets at the cost of being
+	// randomized and iterative.
+	CrossEntropy
+)
+
+// CalibrateConfig controls how Calibrate fits a UserFormula.
+type CalibrateConfig struct {
+	Method CalibrateMethod
+
+	// The following only apply to the CrossEntropy method.
+	Generations int     // number of populations to sample and rank, default 50
+	PopSize     int     // samples per generation, default 64
+	EliteFrac   float64 // fraction of each population used to update (mu, sigma), default 0.2
+	Momentum    float64 // blend factor for updating (mu, sigma) from the elite fraction, default 0.5
+	Seed        int64   // RNG seed, for reproducible fits
+	InitMu      [2]float64
+	InitSigma   [2]float64
+}
+
+// DefaultCalibrateConfig returns a config that selects LinearRegression,
+// with the CrossEntropy parameters set to sane defaults centered on the
+// Epley formula's own coefficients.
+func DefaultCalibrateConfig() CalibrateConfig {
+	return CalibrateConfig{
+		Method:      LinearRegression,
+		Generations: 50,
+		PopSize:     64,
+		EliteFrac:   0.2,
+		Momentum:    0.5,
+		InitMu:      [2]float64{1, 1.0 / 30},
+		InitSigma:   [2]float64{0.5, 0.1},
+	}
+}
+
+// ErrNoSamples is returned by Calibrate when given an empty sample set.
+var ErrNoSamples = errors.New("rm1: calibrate requires at least one sample")
+
+// ErrInvalidSample is returned by Calibrate when a sample's Weight,
+// Reps, or Actual1RM is non-finite, or Weight is zero, since such a
+// sample would otherwise divide-by-zero or propagate NaN into the fit
+// silently.
+var ErrInvalidSample = errors.New("rm1: sample weight must be finite and non-zero, and reps/actual 1RM must be finite")
+
+// Calibrate fits a UserFormula of the form 1RM = weight*(a + b*reps) to the
+// given samples by minimizing mean squared error, using the method
+// specified in cfg.
+func Calibrate(samples []Sample, cfg CalibrateConfig) (UserFormula, error) {
+	if len(samples) == 0 {
+		return UserFormula{}, ErrNoSamples
+	}
+	for _, s := range samples {
+		if !validSample(s) {
+			return UserFormula{}, ErrInvalidSample
+		}
+	}
+
+	var a, b float64
+	switch cfg.Method {
+	case CrossEntropy:
+		a, b = calibrateCrossEntropy(samples, cfg)
+	default:
+		a, b = calibrateLinear(samples)
+	}
+
+	return UserFormula{A: a, B: b, samples: append([]Sample(nil), samples...)}, nil
+}
+
+// validSample reports whether a sample is safe to fit: Weight, Reps, and
+// Actual1RM must all be finite, and Weight must be non-zero since both
+// fitters divide by it.
+func validSample(s Sample) bool {
+	if math.IsNaN(s.Weight) || math.IsInf(s.Weight, 0) || s.Weight == 0 {
+		return false
+	}
+	if math.IsNaN(s.Reps) || math.IsInf(s.Reps, 0) {
+		return false
+	}
+	if math.IsNaN(s.Actual1RM) || math.IsInf(s.Actual1RM, 0) {
+		return false
+	}
+	return true
+}
+
+// calibrateLinear solves Actual1RM/Weight = a + b*Reps by ordinary least
+// squares: b = (n*sum(xy) - sum(x)*sum(y)) / (n*sum(x^2) - sum(x)^2),
+// a = (sum(y) - b*sum(x)) / n.
+func calibrateLinear(samples []Sample) (a, b float64) {
+	n := float64(len(samples))
+	var sumX, sumY, sumXY, sumXX float64
+	for _, s := range samples {
+		x := s.Reps
+		y := s.Actual1RM / s.Weight
+		sumX += x
+		sumY += y
+		sumXY += x * y
+		sumXX += x * x
+	}
+	denom := n*sumXX - sumX*sumX
+	if denom == 0 {
+		return sumY / n, 0
+	}
+	b = (n*sumXY - sumX*sumY) / denom
+	a = (sumY - b*sumX) / n
+	return a, b
+}
+
+// calibrateCrossEntropy fits (a, b) with a cross-entropy / evolution
+// strategy search: each generation samples PopSize candidates from
+// N(mu, sigma), ranks them by MSE, and updates mu/sigma from the
+// EliteFrac best candidates with a momentum term.
+func calibrateCrossEntropy(samples []Sample, cfg CalibrateConfig) (a, b float64) {
+	generations, popSize := cfg.Generations, cfg.PopSize
+	if generations <= 0 {
+		generations = 50
+	}
+	if popSize <= 0 {
+		popSize = 64
+	}
+	eliteFrac := cfg.EliteFrac
+	if eliteFrac <= 0 {
+		eliteFrac = 0.2
+	}
+	momentum := cfg.Momentum
+	if momentum <= 0 {
+		momentum = 0.5
+	}
+	mu := cfg.InitMu
+	if mu == [2]float64{} {
+		mu = [2]float64{1, 1.0 / 30}
+	}
+	sigma := cfg.InitSigma
+	if sigma == [2]float64{} {
+		sigma = [2]float64{0.5, 0.1}
+	}
+
+	rng := rand.New(rand.NewSource(cfg.Seed))
+	numElite := int(float64(popSize) * eliteFrac)
+	if numElite < 1 {
+		numElite = 1
+	}
+
+	for g := 0; g < generations; g++ {
+		pop := make([]ceCandidate, popSize)
+		for i := range pop {
+			ca := mu[0] + sigma[0]*rng.NormFloat64()
+			cb := mu[1] + sigma[1]*rng.NormFloat64()
+			pop[i] = ceCandidate{a: ca, b: cb, mse: mseOf(samples, ca, cb)}
+		}
+		sortCandidatesByMSE(pop)
+
+		elite := pop[:numElite]
+		var meanA, meanB float64
+		for _, c := range elite {
+			meanA += c.a
+			meanB += c.b
+		}
+		meanA /= float64(numElite)
+		meanB /= float64(numElite)
+
+		var varA, varB float64
+		for _, c := range elite {
+			da, db := c.a-meanA, c.b-meanB
+			varA += da * da
+			varB += db * db
+		}
+		varA /= float64(numElite)
+		varB /= float64(numElite)
+
+		mu[0] = momentum*meanA + (1-momentum)*mu[0]
+		mu[1] = momentum*meanB + (1-momentum)*mu[1]
+		sigma[0] = momentum*math.Sqrt(varA) + (1-momentum)*sigma[0]
+		sigma[1] = momentum*math.Sqrt(varB) + (1-momentum)*sigma[1]
+	}
+
+	return mu[0], mu[1]
+}
+
+// ceCandidate is one sampled (a, b) point in the cross-entropy search, with
+// its mean squared error against the calibration samples.
+type ceCandidate struct {
+	a, b float64
+	mse  float64
+}
+
+func mseOf(samples []Sample, a, b float64) float64 {
+	var sum float64
+	for _, s := range samples {
+		predicted := s.Weight * (a + b*s.Reps)
+		d := s.Actual1RM - predicted
+		sum += d * d
+	}
+	return sum / float64(len(samples))
+}
+
+func sortCandidatesByMSE(pop []ceCandidate) {
+	for i := 1; i < len(pop); i++ {
+		for j := i; j > 0 && pop[j].mse < pop[j-1].mse; j-- {
+			pop[j], pop[j-1] = pop[j-1], pop[j]
+		}
+	}
+}
+
+// Rm1WithUser calculates the one-rep max using a calibrated UserFormula.
+func Rm1WithUser(weight, reps float64, formula UserFormula) float64 {
+	return formula.Estimate(weight, reps)
+}
@@ -0,0 +1,138 @@
+package rm1
+
+import (
+	"math"
+	"testing"
+)
+
+// syntheticSamples builds noise-free samples following 1RM = weight*(a +
+// b*reps), the same shape Calibrate fits.
+func syntheticSamples(a, b float64) []Sample {
+	var samples []Sample
+	for _, weight := range []float64{135, 185, 225} {
+		for _, reps := range []float64{1, 3, 5, 8} {
+			samples = append(samples, Sample{
+				Weight:    weight,
+				Reps:      reps,
+				Actual1RM: weight * (a + b*reps),
+			})
+		}
+	}
+	return samples
+}
+
+func TestCalibrateLinearRegression(t *testing.T) {
+	const wantA, wantB = 1.05, 1.0 / 28
+
+	formula, err := Calibrate(syntheticSamples(wantA, wantB), CalibrateConfig{Method: LinearRegression})
+	if err != nil {
+		t.Fatalf("Calibrate() error = %v, want nil", err)
+	}
+	if math.Abs(formula.A-wantA) > 1e-9 {
+		t.Errorf("Calibrate() A = %v, want %v", formula.A, wantA)
+	}
+	if math.Abs(formula.B-wantB) > 1e-9 {
+		t.Errorf("Calibrate() B = %v, want %v", formula.B, wantB)
+	}
+
+	rSquared, residualStdDev := formula.GoodnessOfFit()
+	if math.Abs(rSquared-1) > 1e-6 {
+		t.Errorf("GoodnessOfFit() R^2 = %v, want ~1 on noise-free data", rSquared)
+	}
+	if residualStdDev > 1e-6 {
+		t.Errorf("GoodnessOfFit() residual stddev = %v, want ~0 on noise-free data", residualStdDev)
+	}
+}
+
+func TestCalibrateLinearRegressionEstimateAndPredictReps(t *testing.T) {
+	formula, err := Calibrate(syntheticSamples(1, 1.0/30), CalibrateConfig{Method: LinearRegression})
+	if err != nil {
+		t.Fatalf("Calibrate() error = %v, want nil", err)
+	}
+
+	got := formula.Estimate(200, 5)
+	want := 200 * (1 + 5.0/30)
+	if math.Abs(got-want) > 1e-6 {
+		t.Errorf("Estimate(200, 5) = %v, want %v", got, want)
+	}
+
+	reps := formula.PredictReps(want, 200)
+	if math.Abs(reps-5) > 1e-6 {
+		t.Errorf("PredictReps(%v, 200) = %v, want 5", want, reps)
+	}
+}
+
+func TestCalibrateCrossEntropy(t *testing.T) {
+	const wantA, wantB = 1, 1.0 / 30
+
+	cfg := DefaultCalibrateConfig()
+	cfg.Method = CrossEntropy
+	cfg.Seed = 42
+	cfg.Generations = 200
+
+	formula, err := Calibrate(syntheticSamples(wantA, wantB), cfg)
+	if err != nil {
+		t.Fatalf("Calibrate() error = %v, want nil", err)
+	}
+
+	// The cross-entropy search is stochastic but seeded, so it must
+	// converge close to the true (a, b) within a fixed tolerance.
+	if math.Abs(formula.A-wantA) > 0.05 {
+		t.Errorf("Calibrate(CrossEntropy) A = %v, want ~%v", formula.A, wantA)
+	}
+	if math.Abs(formula.B-wantB) > 0.01 {
+		t.Errorf("Calibrate(CrossEntropy) B = %v, want ~%v", formula.B, wantB)
+	}
+
+	rSquared, _ := formula.GoodnessOfFit()
+	if rSquared < 0.95 {
+		t.Errorf("Calibrate(CrossEntropy) R^2 = %v, want a good fit (>=0.95)", rSquared)
+	}
+}
+
+func TestCalibrateCrossEntropyDeterministicWithSeed(t *testing.T) {
+	cfg := DefaultCalibrateConfig()
+	cfg.Method = CrossEntropy
+	cfg.Seed = 7
+
+	samples := syntheticSamples(1, 1.0/30)
+	a, err := Calibrate(samples, cfg)
+	if err != nil {
+		t.Fatalf("Calibrate() error = %v, want nil", err)
+	}
+	b, err := Calibrate(samples, cfg)
+	if err != nil {
+		t.Fatalf("Calibrate() error = %v, want nil", err)
+	}
+
+	if a.A != b.A || a.B != b.B {
+		t.Errorf("Calibrate(CrossEntropy) with the same seed produced different fits: (%v, %v) vs (%v, %v)", a.A, a.B, b.A, b.B)
+	}
+}
+
+func TestCalibrateErrors(t *testing.T) {
+	t.Run("no samples", func(t *testing.T) {
+		if _, err := Calibrate(nil, DefaultCalibrateConfig()); err != ErrNoSamples {
+			t.Errorf("Calibrate(nil, ...) error = %v, want %v", err, ErrNoSamples)
+		}
+	})
+
+	cases := []struct {
+		name   string
+		sample Sample
+	}{
+		{"zero weight", Sample{Weight: 0, Reps: 5, Actual1RM: 300}},
+		{"NaN weight", Sample{Weight: math.NaN(), Reps: 5, Actual1RM: 300}},
+		{"infinite weight", Sample{Weight: math.Inf(1), Reps: 5, Actual1RM: 300}},
+		{"NaN reps", Sample{Weight: 200, Reps: math.NaN(), Actual1RM: 300}},
+		{"NaN actual 1RM", Sample{Weight: 200, Reps: 5, Actual1RM: math.NaN()}},
+		{"infinite actual 1RM", Sample{Weight: 200, Reps: 5, Actual1RM: math.Inf(-1)}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if _, err := Calibrate([]Sample{c.sample}, DefaultCalibrateConfig()); err != ErrInvalidSample {
+				t.Errorf("Calibrate(%v, ...) error = %v, want %v", c.sample, err, ErrInvalidSample)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package rm1
+
+import (
+	"math"
+
+	"github.com/Logan-Dang/golang-1rm/rm1/internal/stats"
+)
+
+// EnsembleResult summarizes the one-rep max estimates produced by all five
+// formulas for a single weight/reps pair.
+type EnsembleResult struct {
+	Mean    float64
+	Median  float64
+	StdDev  float64
+	Min     float64
+	Max     float64
+	IQR     float64
+	Low95   float64
+	High95  float64
+	samples []float64
+}
+
+// EnsembleOptions configures how Rm1Ensemble summarizes formula outputs.
+type EnsembleOptions struct {
+	// RemoveOutliers drops formula outputs outside [Q1-1.5*IQR, Q3+1.5*IQR]
+	// before summarizing.
+	RemoveOutliers bool
+}
+
+// Rm1Ensemble aggregates all five formula outputs for weight/reps and
+// reports summary statistics across them, optionally dropping outliers
+// first via the interquartile-range rule.
+func Rm1Ensemble(weight, reps float64, opts EnsembleOptions) EnsembleResult {
+	all := Rm1All(weight, reps)
+	vals := make([]float64, 0, len(all))
+	for _, v := range all {
+		vals = append(vals, v)
+	}
+	if opts.RemoveOutliers {
+		vals = stats.RemoveOutliers(vals)
+	}
+	return summarizeEnsemble(vals)
+}
+
+func summarizeEnsemble(vals []float64) EnsembleResult {
+	m := stats.Mean(vals)
+	sd := stats.StdDev(vals)
+	n := float64(len(vals))
+	margin := 1.96 * sd / math.Sqrt(n)
+	return EnsembleResult{
+		Mean:    m,
+		Median:  stats.Quantile(vals, 0.5),
+		StdDev:  sd,
+		Min:     stats.Min(vals),
+		Max:     stats.Max(vals),
+		IQR:     stats.IQR(vals),
+		Low95:   m - margin,
+		High95:  m + margin,
+		samples: append([]float64(nil), vals...),
+	}
+}
+
+// Comparison reports whether two EnsembleResults differ by a statistically
+// meaningful amount.
+type Comparison struct {
+	PercentChange float64
+	PValue        float64
+	Method        string
+}
+
+// CompareEnsembles reports the percent change in mean between a and b,
+// along with a p-value testing whether that difference is statistically
+// meaningful given formula disagreement within each ensemble. It uses
+// Welch's t-test when both ensembles have enough samples, falling back to
+// the Mann-Whitney U test otherwise.
+func CompareEnsembles(a, b EnsembleResult) Comparison {
+	percentChange := (b.Mean - a.Mean) / a.Mean * 100
+
+	const minSamplesForTTest = 3
+	if len(a.samples) >= minSamplesForTTest && len(b.samples) >= minSamplesForTTest {
+		return Comparison{
+			PercentChange: percentChange,
+			PValue:        stats.WelchTTest(a.samples, b.samples),
+			Method:        "welch-t",
+		}
+	}
+	return Comparison{
+		PercentChange: percentChange,
+		PValue:        stats.MannWhitneyU(a.samples, b.samples),
+		Method:        "mann-whitney-u",
+	}
+}
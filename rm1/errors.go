@@ -0,0 +1,178 @@
+package rm1
+
+import (
+	"errors"
+	"math"
+)
+
+// Sentinel errors returned by the E-suffixed variants of this package's
+// functions, following the convention of returning a (float64, error) pair
+// for operations that can fail on invalid input (as math.Sqrt's
+// counterparts do in other languages' standard libraries).
+var (
+	// ErrInvalidWeight is returned when weight is not strictly positive.
+	ErrInvalidWeight = errors.New("rm1: weight must be greater than zero")
+
+	// ErrInvalidReps is returned when reps is less than one.
+	ErrInvalidReps = errors.New("rm1: reps must be at least one")
+
+	// ErrNonFinite is returned when weight or reps is NaN or ±Inf.
+	ErrNonFinite = errors.New("rm1: weight and reps must be finite")
+)
+
+// validateInputs applies the package's validation rules: weight must be
+// strictly positive, reps must be at least one, and neither may be NaN or
+// ±Inf.
+func validateInputs(weight, reps float64) error {
+	if math.IsNaN(weight) || math.IsNaN(reps) || math.IsInf(weight, 0) || math.IsInf(reps, 0) {
+		return ErrNonFinite
+	}
+	if weight <= 0 {
+		return ErrInvalidWeight
+	}
+	if reps < 1 {
+		return ErrInvalidReps
+	}
+	return nil
+}
+
+// validateRepPredictInputs applies the RepPredict* family's validation
+// rules: weight must be strictly positive, and both rm1 and weight must be
+// finite. Unlike validateInputs, rm1 itself is allowed to be non-positive
+// (RepPredict* already define that as "zero reps"), so only its finiteness
+// is checked here.
+func validateRepPredictInputs(rm1, weight float64) error {
+	if math.IsNaN(rm1) || math.IsNaN(weight) || math.IsInf(rm1, 0) || math.IsInf(weight, 0) {
+		return ErrNonFinite
+	}
+	if weight <= 0 {
+		return ErrInvalidWeight
+	}
+	return nil
+}
+
+// Rm1EpleyE calculates the one-rep max using the Epley formula, validating
+// its inputs first.
+func Rm1EpleyE(weight, reps float64) (float64, error) {
+	if err := validateInputs(weight, reps); err != nil {
+		return 0, err
+	}
+	return Rm1Epley(weight, reps), nil
+}
+
+// Rm1BrzyckiE calculates the one-rep max using the Brzycki formula,
+// validating its inputs first.
+func Rm1BrzyckiE(weight, reps float64) (float64, error) {
+	if err := validateInputs(weight, reps); err != nil {
+		return 0, err
+	}
+	return Rm1Brzycki(weight, reps), nil
+}
+
+// Rm1LombardiE calculates the one-rep max using the Lombardi formula,
+// validating its inputs first.
+func Rm1LombardiE(weight, reps float64) (float64, error) {
+	if err := validateInputs(weight, reps); err != nil {
+		return 0, err
+	}
+	return Rm1Lombardi(weight, reps), nil
+}
+
+// Rm1MayhewE calculates the one-rep max using the Mayhew formula,
+// validating its inputs first.
+func Rm1MayhewE(weight, reps float64) (float64, error) {
+	if err := validateInputs(weight, reps); err != nil {
+		return 0, err
+	}
+	return Rm1Mayhew(weight, reps), nil
+}
+
+// Rm1WathanE calculates the one-rep max using the Wathan formula,
+// validating its inputs first.
+func Rm1WathanE(weight, reps float64) (float64, error) {
+	if err := validateInputs(weight, reps); err != nil {
+		return 0, err
+	}
+	return Rm1Wathan(weight, reps), nil
+}
+
+// Rm1E calculates the one-rep max using the specified formula, validating
+// its inputs first.
+func Rm1E(weight, reps float64, formula Rm1Formula) (float64, error) {
+	if err := validateInputs(weight, reps); err != nil {
+		return 0, err
+	}
+	return Rm1(weight, reps, formula), nil
+}
+
+// Rm1AllE calculates the one-rep max using all formulas, validating its
+// inputs first.
+func Rm1AllE(weight, reps float64) (map[Rm1Formula]float64, error) {
+	if err := validateInputs(weight, reps); err != nil {
+		return nil, err
+	}
+	return Rm1All(weight, reps), nil
+}
+
+// RepPredictEpleyE predicts repetitions based on a given 1RM using an
+// inverse of the Epley formula, validating its inputs first.
+func RepPredictEpleyE(rm1, weight float64) (float64, error) {
+	if err := validateRepPredictInputs(rm1, weight); err != nil {
+		return 0, err
+	}
+	return RepPredictEpley(rm1, weight), nil
+}
+
+// RepPredictBrzyckiE predicts repetitions based on a given 1RM using an
+// inverse of the Brzycki formula, validating its inputs first.
+func RepPredictBrzyckiE(rm1, weight float64) (float64, error) {
+	if err := validateRepPredictInputs(rm1, weight); err != nil {
+		return 0, err
+	}
+	return RepPredictBrzycki(rm1, weight), nil
+}
+
+// RepPredictLombardiE predicts repetitions based on a given 1RM using an
+// inverse of the Lombardi formula, validating its inputs first.
+func RepPredictLombardiE(rm1, weight float64) (float64, error) {
+	if err := validateRepPredictInputs(rm1, weight); err != nil {
+		return 0, err
+	}
+	return RepPredictLombardi(rm1, weight), nil
+}
+
+// RepPredictMayhewE predicts repetitions based on a given 1RM using an
+// inverse of the Mayhew formula, validating its inputs first.
+func RepPredictMayhewE(rm1, weight float64) (float64, error) {
+	if err := validateRepPredictInputs(rm1, weight); err != nil {
+		return 0, err
+	}
+	return RepPredictMayhew(rm1, weight), nil
+}
+
+// RepPredictWathanE predicts repetitions based on a given 1RM using an
+// inverse of the Wathan formula, validating its inputs first.
+func RepPredictWathanE(rm1, weight float64) (float64, error) {
+	if err := validateRepPredictInputs(rm1, weight); err != nil {
+		return 0, err
+	}
+	return RepPredictWathan(rm1, weight), nil
+}
+
+// RepPredictAllE predicts repetitions using all formulas based on a given
+// 1RM, validating its inputs first.
+func RepPredictAllE(rm1, weight float64) (map[Rm1Formula]float64, error) {
+	if err := validateRepPredictInputs(rm1, weight); err != nil {
+		return nil, err
+	}
+	return RepPredictAll(rm1, weight), nil
+}
+
+// RepPredictE predicts repetitions based on a given 1RM using the
+// specified formula, validating its inputs first.
+func RepPredictE(rm1, weight float64, formula Rm1Formula) (float64, error) {
+	if err := validateRepPredictInputs(rm1, weight); err != nil {
+		return 0, err
+	}
+	return RepPredict(rm1, weight, formula), nil
+}
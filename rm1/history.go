@@ -0,0 +1,292 @@
+package rm1
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// Set represents a single logged lift: the weight and reps performed at a
+// point in time, along with the formula that should be used to estimate a
+// one-rep max from it.
+type Set struct {
+	Timestamp time.Time
+	Weight    float64
+	Reps      float64
+	Formula   Rm1Formula
+}
+
+// HistoryOptions configures how a LiftHistory reports its statistics.
+type HistoryOptions struct {
+	// RemoveOutliers drops points outside [Q1-1.5*IQR, Q3+1.5*IQR] on the
+	// estimated-1RM distribution before Mean, Median, StdDev, Min, Max,
+	// Percentile, and Rolling are computed. PRs and Trend use every set.
+	RemoveOutliers bool
+}
+
+// LiftHistory stores a time-ordered sequence of Sets for a single lift and
+// exposes rolling statistics over the estimated 1RM series.
+type LiftHistory struct {
+	Options HistoryOptions
+
+	sets []Set
+}
+
+// NewLiftHistory creates an empty LiftHistory with the given options.
+func NewLiftHistory(opts HistoryOptions) *LiftHistory {
+	return &LiftHistory{Options: opts}
+}
+
+// Add appends a set to the history, keeping the series ordered by timestamp.
+func (h *LiftHistory) Add(set Set) {
+	i := sort.Search(len(h.sets), func(i int) bool {
+		return h.sets[i].Timestamp.After(set.Timestamp)
+	})
+	h.sets = append(h.sets, Set{})
+	copy(h.sets[i+1:], h.sets[i:])
+	h.sets[i] = set
+}
+
+// Sets returns the logged sets in timestamp order.
+func (h *LiftHistory) Sets() []Set {
+	return h.sets
+}
+
+// Estimated1RMs returns the estimated one-rep max for every logged set, in
+// timestamp order, applying each set's configured formula.
+func (h *LiftHistory) Estimated1RMs() []float64 {
+	out := make([]float64, len(h.sets))
+	for i, s := range h.sets {
+		out[i] = Rm1(s.Weight, s.Reps, s.Formula)
+	}
+	return out
+}
+
+// series returns the estimated 1RM series, with outliers removed first when
+// Options.RemoveOutliers is set.
+func (h *LiftHistory) series() []float64 {
+	vals := h.Estimated1RMs()
+	if h.Options.RemoveOutliers {
+		vals = removeOutliersIQR(vals)
+	}
+	return vals
+}
+
+// Mean returns the mean of the estimated 1RM series.
+func (h *LiftHistory) Mean() float64 {
+	return mean(h.series())
+}
+
+// Median returns the median of the estimated 1RM series.
+func (h *LiftHistory) Median() float64 {
+	return percentile(h.series(), 50)
+}
+
+// StdDev returns the sample standard deviation of the estimated 1RM series.
+func (h *LiftHistory) StdDev() float64 {
+	return stdDev(h.series())
+}
+
+// Min returns the smallest estimated 1RM in the series.
+func (h *LiftHistory) Min() float64 {
+	vals := h.series()
+	if len(vals) == 0 {
+		return math.NaN()
+	}
+	m := vals[0]
+	for _, v := range vals[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
+
+// Max returns the largest estimated 1RM in the series.
+func (h *LiftHistory) Max() float64 {
+	vals := h.series()
+	if len(vals) == 0 {
+		return math.NaN()
+	}
+	m := vals[0]
+	for _, v := range vals[1:] {
+		if v > m {
+			m = v
+		}
+	}
+	return m
+}
+
+// Percentile returns the p-th percentile (0-100) of the estimated 1RM
+// series, using linear interpolation between closest ranks.
+func (h *LiftHistory) Percentile(p float64) float64 {
+	return percentile(h.series(), p)
+}
+
+// Rolling returns the moving average and moving maximum of the estimated
+// 1RM series over the given window size. Both slices have the same length
+// as Estimated1RMs (minus any outliers dropped when Options.RemoveOutliers
+// is set), with the first window-1 entries computed over the partial window
+// available so far.
+func (h *LiftHistory) Rolling(window int) (movingAvg []float64, movingMax []float64) {
+	vals := h.series()
+	if window < 1 {
+		window = 1
+	}
+	movingAvg = make([]float64, len(vals))
+	movingMax = make([]float64, len(vals))
+	for i := range vals {
+		start := i - window + 1
+		if start < 0 {
+			start = 0
+		}
+		chunk := vals[start : i+1]
+		movingAvg[i] = mean(chunk)
+		m := chunk[0]
+		for _, v := range chunk[1:] {
+			if v > m {
+				m = v
+			}
+		}
+		movingMax[i] = m
+	}
+	return movingAvg, movingMax
+}
+
+// PRs walks the series in timestamp order and returns the sets whose
+// estimated 1RM exceeds every prior entry.
+func (h *LiftHistory) PRs() []Set {
+	var prs []Set
+	best := math.Inf(-1)
+	for _, s := range h.sets {
+		est := Rm1(s.Weight, s.Reps, s.Formula)
+		if est > best {
+			best = est
+			prs = append(prs, s)
+		}
+	}
+	return prs
+}
+
+// Trend fits a simple least-squares line of estimated 1RM vs. days since
+// the first logged set, returning the slope (1RM change per day) and
+// intercept.
+func (h *LiftHistory) Trend() (slope, intercept float64) {
+	if len(h.sets) < 2 {
+		return 0, 0
+	}
+	start := h.sets[0].Timestamp
+	xs := make([]float64, len(h.sets))
+	ys := h.Estimated1RMs()
+	for i, s := range h.sets {
+		xs[i] = s.Timestamp.Sub(start).Hours() / 24
+	}
+	return leastSquares(xs, ys)
+}
+
+// RemoveOutliers drops sets whose estimated 1RM falls outside
+// [Q1-1.5*IQR, Q3+1.5*IQR] on the estimated-1RM distribution, replacing the
+// history in place.
+func (h *LiftHistory) RemoveOutliers() {
+	ests := h.Estimated1RMs()
+	if len(ests) == 0 {
+		return
+	}
+	q1 := percentile(ests, 25)
+	q3 := percentile(ests, 75)
+	iqr := q3 - q1
+	lo, hi := q1-1.5*iqr, q3+1.5*iqr
+
+	kept := h.sets[:0:0]
+	for i, s := range h.sets {
+		if ests[i] >= lo && ests[i] <= hi {
+			kept = append(kept, s)
+		}
+	}
+	h.sets = kept
+}
+
+func removeOutliersIQR(vals []float64) []float64 {
+	if len(vals) == 0 {
+		return vals
+	}
+	q1 := percentile(vals, 25)
+	q3 := percentile(vals, 75)
+	iqr := q3 - q1
+	lo, hi := q1-1.5*iqr, q3+1.5*iqr
+
+	out := vals[:0:0]
+	for _, v := range vals {
+		if v >= lo && v <= hi {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+func mean(vals []float64) float64 {
+	if len(vals) == 0 {
+		return math.NaN()
+	}
+	var sum float64
+	for _, v := range vals {
+		sum += v
+	}
+	return sum / float64(len(vals))
+}
+
+func stdDev(vals []float64) float64 {
+	if len(vals) < 2 {
+		return 0
+	}
+	m := mean(vals)
+	var sumSq float64
+	for _, v := range vals {
+		d := v - m
+		sumSq += d * d
+	}
+	return math.Sqrt(sumSq / float64(len(vals)-1))
+}
+
+// percentile computes the p-th percentile (0-100) of vals using linear
+// interpolation between closest ranks.
+func percentile(vals []float64, p float64) float64 {
+	if len(vals) == 0 {
+		return math.NaN()
+	}
+	sorted := append([]float64(nil), vals...)
+	sort.Float64s(sorted)
+	if len(sorted) == 1 {
+		return sorted[0]
+	}
+	rank := p / 100 * float64(len(sorted)-1)
+	lo := int(math.Floor(rank))
+	hi := int(math.Ceil(rank))
+	if lo == hi {
+		return sorted[lo]
+	}
+	frac := rank - float64(lo)
+	return sorted[lo] + frac*(sorted[hi]-sorted[lo])
+}
+
+// leastSquares fits y = slope*x + intercept by ordinary least squares.
+func leastSquares(xs, ys []float64) (slope, intercept float64) {
+	n := float64(len(xs))
+	if n == 0 {
+		return 0, 0
+	}
+	var sumX, sumY, sumXY, sumXX float64
+	for i := range xs {
+		sumX += xs[i]
+		sumY += ys[i]
+		sumXY += xs[i] * ys[i]
+		sumXX += xs[i] * xs[i]
+	}
+	denom := n*sumXX - sumX*sumX
+	if denom == 0 {
+		return 0, sumY / n
+	}
+	slope = (n*sumXY - sumX*sumY) / denom
+	intercept = (sumY - slope*sumX) / n
+	return slope, intercept
+}
@@ -0,0 +1,140 @@
+package rm1
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func day(n int) time.Time {
+	return time.Date(2026, time.January, 1+n, 0, 0, 0, 0, time.UTC)
+}
+
+func TestLiftHistoryAddOrdering(t *testing.T) {
+	h := NewLiftHistory(HistoryOptions{})
+	h.Add(Set{Timestamp: day(2), Weight: 200, Reps: 1, Formula: Epley})
+	h.Add(Set{Timestamp: day(0), Weight: 180, Reps: 1, Formula: Epley})
+	h.Add(Set{Timestamp: day(1), Weight: 190, Reps: 1, Formula: Epley})
+
+	sets := h.Sets()
+	want := []float64{180, 190, 200}
+	if len(sets) != len(want) {
+		t.Fatalf("Sets() has %d entries, want %d", len(sets), len(want))
+	}
+	for i, s := range sets {
+		if s.Weight != want[i] {
+			t.Errorf("Sets()[%d].Weight = %v, want %v", i, s.Weight, want[i])
+		}
+	}
+}
+
+func TestLiftHistoryAddStableOnTies(t *testing.T) {
+	h := NewLiftHistory(HistoryOptions{})
+	first := Set{Timestamp: day(0), Weight: 100, Reps: 1, Formula: Epley}
+	second := Set{Timestamp: day(0), Weight: 101, Reps: 1, Formula: Epley}
+	h.Add(first)
+	h.Add(second)
+
+	sets := h.Sets()
+	if sets[0].Weight != 100 || sets[1].Weight != 101 {
+		t.Errorf("Add() with equal timestamps reordered entries: got %v, want insertion order preserved", sets)
+	}
+}
+
+func TestLiftHistoryPRs(t *testing.T) {
+	h := NewLiftHistory(HistoryOptions{})
+	h.Add(Set{Timestamp: day(0), Weight: 100, Reps: 1, Formula: Epley}) // 1RM 100, PR
+	h.Add(Set{Timestamp: day(1), Weight: 90, Reps: 1, Formula: Epley})  // 1RM 90, not a PR
+	h.Add(Set{Timestamp: day(2), Weight: 110, Reps: 1, Formula: Epley}) // 1RM 110, PR
+	h.Add(Set{Timestamp: day(3), Weight: 110, Reps: 1, Formula: Epley}) // 1RM 110, ties prior best, not a PR
+
+	prs := h.PRs()
+	if len(prs) != 2 {
+		t.Fatalf("PRs() returned %d entries, want 2: %v", len(prs), prs)
+	}
+	if prs[0].Weight != 100 || prs[1].Weight != 110 {
+		t.Errorf("PRs() = %v, want weights [100, 110]", prs)
+	}
+}
+
+func TestLiftHistoryRolling(t *testing.T) {
+	h := NewLiftHistory(HistoryOptions{})
+	for i, w := range []float64{100, 200, 300, 400} {
+		h.Add(Set{Timestamp: day(i), Weight: w, Reps: 1, Formula: Epley})
+	}
+
+	avg, max := h.Rolling(2)
+	if len(avg) != 4 || len(max) != 4 {
+		t.Fatalf("Rolling(2) returned lengths (%d, %d), want (4, 4)", len(avg), len(max))
+	}
+
+	// Window 2 over [100, 200, 300, 400]: the first entry has only a
+	// partial window of size 1, every entry after has a full window of 2.
+	wantAvg := []float64{100, 150, 250, 350}
+	wantMax := []float64{100, 200, 300, 400}
+	for i := range wantAvg {
+		if avg[i] != wantAvg[i] {
+			t.Errorf("Rolling(2) avg[%d] = %v, want %v", i, avg[i], wantAvg[i])
+		}
+		if max[i] != wantMax[i] {
+			t.Errorf("Rolling(2) max[%d] = %v, want %v", i, max[i], wantMax[i])
+		}
+	}
+}
+
+func TestLiftHistoryTrend(t *testing.T) {
+	h := NewLiftHistory(HistoryOptions{})
+
+	// A single set cannot support a trend line.
+	h.Add(Set{Timestamp: day(0), Weight: 100, Reps: 1, Formula: Epley})
+	if slope, intercept := h.Trend(); slope != 0 || intercept != 0 {
+		t.Errorf("Trend() with 1 set = (%v, %v), want (0, 0)", slope, intercept)
+	}
+
+	// Two sets ten days apart, gaining exactly 10 in estimated 1RM, should
+	// fit a slope of 1/day through both points.
+	h2 := NewLiftHistory(HistoryOptions{})
+	h2.Add(Set{Timestamp: day(0), Weight: 100, Reps: 1, Formula: Epley})
+	h2.Add(Set{Timestamp: day(10), Weight: 110, Reps: 1, Formula: Epley})
+	slope, intercept := h2.Trend()
+	if math.Abs(slope-1) > 1e-9 {
+		t.Errorf("Trend() slope = %v, want 1", slope)
+	}
+	if math.Abs(intercept-100) > 1e-9 {
+		t.Errorf("Trend() intercept = %v, want 100", intercept)
+	}
+}
+
+func TestLiftHistoryRemoveOutliers(t *testing.T) {
+	h := NewLiftHistory(HistoryOptions{})
+	for i, w := range []float64{100, 101, 99, 102, 98, 1000} {
+		h.Add(Set{Timestamp: day(i), Weight: w, Reps: 1, Formula: Epley})
+	}
+
+	h.RemoveOutliers()
+
+	for _, s := range h.Sets() {
+		if s.Weight == 1000 {
+			t.Errorf("RemoveOutliers() left the 1000 outlier in place: %v", h.Sets())
+		}
+	}
+	if len(h.Sets()) != 5 {
+		t.Errorf("RemoveOutliers() left %d sets, want 5", len(h.Sets()))
+	}
+}
+
+func TestLiftHistoryOptionsRemoveOutliers(t *testing.T) {
+	h := NewLiftHistory(HistoryOptions{RemoveOutliers: true})
+	for i, w := range []float64{100, 101, 99, 102, 98, 1000} {
+		h.Add(Set{Timestamp: day(i), Weight: w, Reps: 1, Formula: Epley})
+	}
+
+	// The outlier must be excluded from reported stats without mutating
+	// the underlying, uncleaned history.
+	if got := h.Max(); got == 1000 {
+		t.Errorf("Max() = %v, want the outlier excluded from stats", got)
+	}
+	if len(h.Sets()) != 6 {
+		t.Errorf("Sets() returned %d entries, want all 6 sets kept (only reported stats are filtered)", len(h.Sets()))
+	}
+}
@@ -0,0 +1,290 @@
+// Package stats implements the handful of statistics primitives rm1 needs
+// to summarize and compare formula outputs, so the module stays
+// dependency-free.
+package stats
+
+import (
+	"math"
+	"sort"
+)
+
+// Mean returns the arithmetic mean of vals.
+func Mean(vals []float64) float64 {
+	if len(vals) == 0 {
+		return math.NaN()
+	}
+	var sum float64
+	for _, v := range vals {
+		sum += v
+	}
+	return sum / float64(len(vals))
+}
+
+// Variance returns the sample variance of vals (divisor n-1).
+func Variance(vals []float64) float64 {
+	if len(vals) < 2 {
+		return 0
+	}
+	m := Mean(vals)
+	var sumSq float64
+	for _, v := range vals {
+		d := v - m
+		sumSq += d * d
+	}
+	return sumSq / float64(len(vals)-1)
+}
+
+// StdDev returns the sample standard deviation of vals.
+func StdDev(vals []float64) float64 {
+	return math.Sqrt(Variance(vals))
+}
+
+// Quantile returns the p-th quantile (0-1) of vals using the linear
+// interpolation of the modes (type 7) method, the default used by R and
+// NumPy's "linear" interpolation.
+func Quantile(vals []float64, p float64) float64 {
+	if len(vals) == 0 {
+		return math.NaN()
+	}
+	sorted := append([]float64(nil), vals...)
+	sort.Float64s(sorted)
+	if len(sorted) == 1 {
+		return sorted[0]
+	}
+	h := p*float64(len(sorted)-1) + 1
+	lo := int(math.Floor(h))
+	hi := int(math.Ceil(h))
+	if lo < 1 {
+		lo = 1
+	}
+	if hi > len(sorted) {
+		hi = len(sorted)
+	}
+	frac := h - float64(lo)
+	return sorted[lo-1] + frac*(sorted[hi-1]-sorted[lo-1])
+}
+
+// IQR returns the interquartile range (Q3-Q1) of vals.
+func IQR(vals []float64) float64 {
+	return Quantile(vals, 0.75) - Quantile(vals, 0.25)
+}
+
+// RemoveOutliers drops points outside [Q1-1.5*IQR, Q3+1.5*IQR].
+func RemoveOutliers(vals []float64) []float64 {
+	if len(vals) == 0 {
+		return vals
+	}
+	q1 := Quantile(vals, 0.25)
+	q3 := Quantile(vals, 0.75)
+	iqr := q3 - q1
+	lo, hi := q1-1.5*iqr, q3+1.5*iqr
+
+	out := vals[:0:0]
+	for _, v := range vals {
+		if v >= lo && v <= hi {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// Min returns the smallest value in vals.
+func Min(vals []float64) float64 {
+	if len(vals) == 0 {
+		return math.NaN()
+	}
+	m := vals[0]
+	for _, v := range vals[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
+
+// Max returns the largest value in vals.
+func Max(vals []float64) float64 {
+	if len(vals) == 0 {
+		return math.NaN()
+	}
+	m := vals[0]
+	for _, v := range vals[1:] {
+		if v > m {
+			m = v
+		}
+	}
+	return m
+}
+
+// WelchT returns the t statistic and degrees of freedom for Welch's
+// two-sample t-test, which does not assume the two samples share a
+// variance.
+func WelchT(a, b []float64) (t, df float64) {
+	na, nb := float64(len(a)), float64(len(b))
+	va, vb := Variance(a), Variance(b)
+	seA, seB := va/na, vb/nb
+
+	t = (Mean(a) - Mean(b)) / math.Sqrt(seA+seB)
+	df = (seA + seB) * (seA + seB) / (seA*seA/(na-1) + seB*seB/(nb-1))
+	return t, df
+}
+
+// WelchTTest returns the two-sided p-value of Welch's t-test comparing the
+// means of a and b.
+func WelchTTest(a, b []float64) float64 {
+	t, df := WelchT(a, b)
+	return 2 * (1 - TCDF(math.Abs(t), df))
+}
+
+// MannWhitneyU returns the two-sided p-value of the Mann-Whitney U test
+// comparing a and b, using a normal approximation to the U distribution.
+// It is used as a fallback when either sample is too small for Welch's
+// t-test to be reliable.
+func MannWhitneyU(a, b []float64) float64 {
+	na, nb := len(a), len(b)
+	if na == 0 || nb == 0 {
+		return math.NaN()
+	}
+
+	type ranked struct {
+		val   float64
+		group int
+	}
+	all := make([]ranked, 0, na+nb)
+	for _, v := range a {
+		all = append(all, ranked{v, 0})
+	}
+	for _, v := range b {
+		all = append(all, ranked{v, 1})
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].val < all[j].val })
+
+	ranks := make([]float64, len(all))
+	for i := 0; i < len(all); {
+		j := i
+		for j < len(all) && all[j].val == all[i].val {
+			j++
+		}
+		avgRank := float64(i+j+1) / 2
+		for k := i; k < j; k++ {
+			ranks[k] = avgRank
+		}
+		i = j
+	}
+
+	var rankSumA float64
+	for i, r := range all {
+		if r.group == 0 {
+			rankSumA += ranks[i]
+		}
+	}
+
+	nA, nB := float64(na), float64(nb)
+	u := rankSumA - nA*(nA+1)/2
+	meanU := nA * nB / 2
+	stdU := math.Sqrt(nA * nB * (nA + nB + 1) / 12)
+	if stdU == 0 {
+		return 1
+	}
+
+	z := (u - meanU) / stdU
+	return 2 * (1 - normalCDF(math.Abs(z)))
+}
+
+func normalCDF(z float64) float64 {
+	return 0.5 * math.Erfc(-z/math.Sqrt2)
+}
+
+// TCDF returns the cumulative distribution function of the Student's
+// t-distribution with df degrees of freedom, evaluated at t, computed via
+// the regularized incomplete beta function.
+func TCDF(t float64, df float64) float64 {
+	x := df / (df + t*t)
+	ib := regularizedIncompleteBeta(x, df/2, 0.5)
+	if t > 0 {
+		return 1 - 0.5*ib
+	}
+	return 0.5 * ib
+}
+
+// regularizedIncompleteBeta computes I_x(a, b) via Lentz's continued
+// fraction algorithm, following the betai/betacf routines in Numerical
+// Recipes.
+func regularizedIncompleteBeta(x, a, b float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	if x >= 1 {
+		return 1
+	}
+
+	lnBeta := lgamma(a+b) - lgamma(a) - lgamma(b)
+	front := math.Exp(lnBeta + a*math.Log(x) + b*math.Log(1-x))
+
+	if x < (a+1)/(a+b+2) {
+		return front * betaContinuedFraction(x, a, b) / a
+	}
+	return 1 - front*betaContinuedFraction(1-x, b, a)/b
+}
+
+// betaContinuedFraction evaluates the continued fraction at the core of the
+// incomplete beta function using Lentz's algorithm (Numerical Recipes'
+// betacf).
+func betaContinuedFraction(x, a, b float64) float64 {
+	const maxIter = 200
+	const epsilon = 3e-14
+	const tiny = 1e-300
+
+	qab := a + b
+	qap := a + 1
+	qam := a - 1
+
+	c := 1.0
+	d := 1 - qab*x/qap
+	if math.Abs(d) < tiny {
+		d = tiny
+	}
+	d = 1 / d
+	h := d
+
+	for m := 1; m <= maxIter; m++ {
+		m2 := float64(2 * m)
+
+		aa := float64(m) * (b - float64(m)) * x / ((qam + m2) * (a + m2))
+		d = 1 + aa*d
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = 1 + aa/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		h *= d * c
+
+		aa = -(a + float64(m)) * (qab + float64(m)) * x / ((a + m2) * (qap + m2))
+		d = 1 + aa*d
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = 1 + aa/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		del := d * c
+		h *= del
+
+		if math.Abs(del-1) < epsilon {
+			break
+		}
+	}
+
+	return h
+}
+
+// lgamma returns the natural log of the gamma function.
+func lgamma(x float64) float64 {
+	v, _ := math.Lgamma(x)
+	return v
+}
@@ -0,0 +1,173 @@
+package stats
+
+import (
+	"math"
+	"testing"
+)
+
+// alike reports whether x and y are equal, treating NaN as equal to NaN.
+func alike(x, y float64) bool {
+	if math.IsNaN(x) && math.IsNaN(y) {
+		return true
+	}
+	return x == y
+}
+
+func approxEqual(got, want, tol float64) bool {
+	return math.Abs(got-want) <= tol
+}
+
+func TestMeanVarianceStdDev(t *testing.T) {
+	vals := []float64{2, 4, 4, 4, 5, 5, 7, 9}
+	if got := Mean(vals); !approxEqual(got, 5, 1e-9) {
+		t.Errorf("Mean(%v) = %v, want 5", vals, got)
+	}
+	// Sample variance (divisor n-1) of this classic example is 32/7.
+	if got := Variance(vals); !approxEqual(got, 32.0/7, 1e-9) {
+		t.Errorf("Variance(%v) = %v, want %v", vals, got, 32.0/7)
+	}
+	if got := StdDev(vals); !approxEqual(got, math.Sqrt(32.0/7), 1e-9) {
+		t.Errorf("StdDev(%v) = %v, want %v", vals, got, math.Sqrt(32.0/7))
+	}
+}
+
+func TestQuantile(t *testing.T) {
+	vals := []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	cases := []struct {
+		p    float64
+		want float64
+	}{
+		{0, 1},
+		{1, 10},
+		{0.5, 5.5},
+		{0.25, 3.25},
+		{0.75, 7.75},
+	}
+	for _, c := range cases {
+		if got := Quantile(vals, c.p); !approxEqual(got, c.want, 1e-9) {
+			t.Errorf("Quantile(%v, %v) = %v, want %v", vals, c.p, got, c.want)
+		}
+	}
+
+	if got := Quantile([]float64{42}, 0.5); got != 42 {
+		t.Errorf("Quantile single-element = %v, want 42", got)
+	}
+	if got := Quantile(nil, 0.5); !math.IsNaN(got) {
+		t.Errorf("Quantile(nil, 0.5) = %v, want NaN", got)
+	}
+}
+
+func TestIQRAndRemoveOutliers(t *testing.T) {
+	vals := []float64{1, 2, 3, 4, 5, 100}
+	q1, q3 := Quantile(vals, 0.25), Quantile(vals, 0.75)
+	iqr := IQR(vals)
+	if !approxEqual(iqr, q3-q1, 1e-9) {
+		t.Errorf("IQR(%v) = %v, want %v", vals, iqr, q3-q1)
+	}
+
+	cleaned := RemoveOutliers(vals)
+	for _, v := range cleaned {
+		if v == 100 {
+			t.Errorf("RemoveOutliers(%v) = %v, want 100 dropped as an outlier", vals, cleaned)
+		}
+	}
+
+	// A value exactly on the boundary must be kept, not dropped. For
+	// [-5, 10, 15, 20, 35], Q1 is 10 and Q3 is 20, so the fences
+	// Q1-1.5*IQR and Q3+1.5*IQR are exactly -5 and 35.
+	withBoundary := []float64{-5, 10, 15, 20, 35}
+	lo := Quantile(withBoundary, 0.25) - 1.5*IQR(withBoundary)
+	hi := Quantile(withBoundary, 0.75) + 1.5*IQR(withBoundary)
+	cleaned = RemoveOutliers(withBoundary)
+	foundLo, foundHi := false, false
+	for _, v := range cleaned {
+		if v == lo {
+			foundLo = true
+		}
+		if v == hi {
+			foundHi = true
+		}
+	}
+	if !foundLo || !foundHi {
+		t.Errorf("RemoveOutliers(%v) = %v, want boundary values %v and %v kept", withBoundary, cleaned, lo, hi)
+	}
+
+	if got := RemoveOutliers(nil); len(got) != 0 {
+		t.Errorf("RemoveOutliers(nil) = %v, want empty", got)
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	vals := []float64{3, -1, 4, 1, 5, 9, 2, 6}
+	if got := Min(vals); got != -1 {
+		t.Errorf("Min(%v) = %v, want -1", vals, got)
+	}
+	if got := Max(vals); got != 9 {
+		t.Errorf("Max(%v) = %v, want 9", vals, got)
+	}
+}
+
+func TestTCDFAgainstTTable(t *testing.T) {
+	// Standard two-tailed critical values for df=10: t=2.228 is the 0.975
+	// quantile (two-sided alpha=0.05), t=1.812 is the 0.95 quantile
+	// (two-sided alpha=0.10).
+	cases := []struct {
+		t    float64
+		df   float64
+		want float64
+	}{
+		{2.228, 10, 0.975},
+		{1.812, 10, 0.95},
+		{0, 10, 0.5},
+	}
+	for _, c := range cases {
+		if got := TCDF(c.t, c.df); !approxEqual(got, c.want, 1e-3) {
+			t.Errorf("TCDF(%v, %v) = %v, want %v", c.t, c.df, got, c.want)
+		}
+	}
+
+	// TCDF is an odd function around 0.5: CDF(-t) = 1 - CDF(t).
+	if got, want := TCDF(-2.228, 10), 1-TCDF(2.228, 10); !approxEqual(got, want, 1e-9) {
+		t.Errorf("TCDF(-2.228, 10) = %v, want %v", got, want)
+	}
+}
+
+func TestWelchTTest(t *testing.T) {
+	// Two samples with an obvious, large mean separation and low variance
+	// should be judged significantly different.
+	a := []float64{10, 11, 9, 10, 10}
+	b := []float64{20, 21, 19, 20, 20}
+	if p := WelchTTest(a, b); p > 0.01 {
+		t.Errorf("WelchTTest(clearly different) p-value = %v, want < 0.01", p)
+	}
+
+	// Identical samples must not be judged significantly different.
+	if p := WelchTTest(a, a); !approxEqual(p, 1, 1e-6) {
+		t.Errorf("WelchTTest(a, a) p-value = %v, want 1", p)
+	}
+}
+
+func TestMannWhitneyU(t *testing.T) {
+	// Two fully separated samples should be judged significantly different.
+	a := []float64{1, 2, 3, 4, 5}
+	b := []float64{6, 7, 8, 9, 10}
+	if p := MannWhitneyU(a, b); p > 0.05 {
+		t.Errorf("MannWhitneyU(fully separated) p-value = %v, want < 0.05", p)
+	}
+
+	// Identical samples, including ties within and across groups, must not
+	// be judged significantly different.
+	c := []float64{1, 1, 1, 1, 1}
+	d := []float64{1, 1, 1, 1, 1}
+	if p := MannWhitneyU(c, d); !approxEqual(p, 1, 1e-6) {
+		t.Errorf("MannWhitneyU(identical with ties) p-value = %v, want 1", p)
+	}
+
+	// A sample with a tie straddling both groups should still produce a
+	// finite, sane p-value rather than NaN or a panic.
+	e := []float64{1, 2, 2, 3}
+	f := []float64{2, 2, 4, 5}
+	if p := MannWhitneyU(e, f); math.IsNaN(p) || p < 0 || p > 1 {
+		t.Errorf("MannWhitneyU(tied across groups) p-value = %v, want a value in [0, 1]", p)
+	}
+}
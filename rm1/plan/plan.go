@@ -0,0 +1,177 @@
+// Package plan builds prescribed working sets on top of the rm1 formulas,
+// converting a percentage of 1RM or an RPE target into a concrete weight
+// so a lift can be programmed, not just estimated.
+package plan
+
+import (
+	"math"
+
+	"github.com/Logan-Dang/golang-1rm/rm1"
+)
+
+// RPEChart maps (reps, RPE) to a percentage of 1RM. Percent[i][j] is the
+// %1RM for Reps[i] reps performed at RPEs[j]. Both axes must be sorted
+// ascending.
+type RPEChart struct {
+	Reps    []int
+	RPEs    []float64
+	Percent [][]float64
+}
+
+// DefaultRPEChart is the chart used by LoadForRPE and Session when no chart
+// is supplied. It covers 1-12 reps and RPE 6-10 in half-point steps, built
+// from the standard rule of thumb that RPE 10 at 1 rep is 100% of 1RM, and
+// %1RM drops about 2.5 points per additional rep and about 2.5 points per
+// RIR (reps in reserve) below RPE 10. To use different assumptions, build
+// your own RPEChart and pass it to RPEChart.LoadForRPE or
+// SessionOptions.Chart rather than overwriting this var, which is shared
+// process-wide and not safe to mutate concurrently.
+var DefaultRPEChart = buildDefaultRPEChart()
+
+func buildDefaultRPEChart() RPEChart {
+	reps := make([]int, 12)
+	for i := range reps {
+		reps[i] = i + 1
+	}
+	rpes := make([]float64, 9)
+	for i := range rpes {
+		rpes[i] = 6 + 0.5*float64(i)
+	}
+
+	percent := make([][]float64, len(reps))
+	for i, r := range reps {
+		row := make([]float64, len(rpes))
+		for j, rpe := range rpes {
+			row[j] = 100 - 2.5*float64(r-1) - 2.5*(10-rpe)
+		}
+		percent[i] = row
+	}
+
+	return RPEChart{Reps: reps, RPEs: rpes, Percent: percent}
+}
+
+// LoadForRPE returns the weight that corresponds to lifting reps
+// repetitions at the given RPE, for a lifter with the given 1RM, looking up
+// DefaultRPEChart. To use a different chart, call RPEChart.LoadForRPE on it
+// directly instead.
+func LoadForRPE(rm1Val float64, reps int, rpe float64) float64 {
+	return DefaultRPEChart.LoadForRPE(rm1Val, reps, rpe)
+}
+
+// LoadForRPE returns the weight that corresponds to lifting reps
+// repetitions at the given RPE, for a lifter with the given 1RM, according
+// to this chart. It bilinearly interpolates between table cells when reps
+// or rpe falls between them, and clamps at the edges of the chart.
+func (c RPEChart) LoadForRPE(rm1Val float64, reps int, rpe float64) float64 {
+	return c.percentFor(reps, rpe) / 100 * rm1Val
+}
+
+// percentFor returns the %1RM for reps reps at the given RPE, bilinearly
+// interpolating the chart.
+func (c RPEChart) percentFor(reps int, rpe float64) float64 {
+	repsAxis := make([]float64, len(c.Reps))
+	for i, r := range c.Reps {
+		repsAxis[i] = float64(r)
+	}
+	return bilinear(repsAxis, c.RPEs, c.Percent, float64(reps), rpe)
+}
+
+// bilinear looks up (x, y) in a grid indexed by two ascending axes,
+// interpolating linearly along each axis in turn. Values outside the axes
+// are clamped to the nearest edge.
+func bilinear(xAxis, yAxis []float64, grid [][]float64, x, y float64) float64 {
+	x0, x1, xf := interpIndices(xAxis, x)
+	y0, y1, yf := interpIndices(yAxis, y)
+
+	vx0 := grid[x0][y0] + (grid[x1][y0]-grid[x0][y0])*xf
+	vx1 := grid[x0][y1] + (grid[x1][y1]-grid[x0][y1])*xf
+	return vx0 + (vx1-vx0)*yf
+}
+
+// interpIndices finds the pair of axis indices that bracket v and the
+// fractional distance between them, clamping v to the axis's range.
+func interpIndices(axis []float64, v float64) (lo, hi int, frac float64) {
+	if v <= axis[0] {
+		return 0, 0, 0
+	}
+	if v >= axis[len(axis)-1] {
+		return len(axis) - 1, len(axis) - 1, 0
+	}
+	for i := 1; i < len(axis); i++ {
+		if v <= axis[i] {
+			lo, hi = i-1, i
+			frac = (v - axis[lo]) / (axis[hi] - axis[lo])
+			return lo, hi, frac
+		}
+	}
+	return len(axis) - 1, len(axis) - 1, 0
+}
+
+// LoadForPercent returns the weight corresponding to the given percentage
+// of a 1RM.
+func LoadForPercent(rm1Val, percent float64) float64 {
+	return rm1Val * percent / 100
+}
+
+// RepsAtLoad is the inverse of LoadForRPE/LoadForPercent: it predicts how
+// many reps a lifter with the given 1RM could perform at weight, using the
+// specified formula.
+func RepsAtLoad(rm1Val, weight float64, formula rm1.Rm1Formula) float64 {
+	return rm1.RepPredict(rm1Val, weight, formula)
+}
+
+// SetPrescription describes one prescribed working set, either as an RPE
+// target or as a flat percentage of 1RM. Set exactly one of RPE or
+// Percent; RPE takes precedence when both are non-zero.
+type SetPrescription struct {
+	Reps    int
+	RPE     float64
+	Percent float64
+}
+
+// WorkingSet is a SetPrescription expanded into a concrete weight.
+type WorkingSet struct {
+	Reps   int
+	Weight float64
+}
+
+// SessionOptions configures how Session expands a scheme into WorkingSets.
+type SessionOptions struct {
+	// RoundTo rounds every computed weight to the nearest multiple of this
+	// value, e.g. RoundTo: 2.5 for a gym's smallest plate increment. Zero
+	// disables rounding.
+	RoundTo float64
+
+	// Chart is consulted for any SetPrescription that specifies an RPE.
+	// The zero value falls back to DefaultRPEChart.
+	Chart RPEChart
+}
+
+// Session expands a scheme of SetPrescriptions into concrete WorkingSets
+// for a lifter with the given 1RM.
+func Session(rm1Val float64, scheme []SetPrescription, opts SessionOptions) []WorkingSet {
+	chart := opts.Chart
+	if chart.Reps == nil {
+		chart = DefaultRPEChart
+	}
+
+	sets := make([]WorkingSet, len(scheme))
+	for i, rx := range scheme {
+		var weight float64
+		if rx.RPE > 0 {
+			weight = chart.LoadForRPE(rm1Val, rx.Reps, rx.RPE)
+		} else {
+			weight = LoadForPercent(rm1Val, rx.Percent)
+		}
+		if opts.RoundTo > 0 {
+			weight = roundTo(weight, opts.RoundTo)
+		}
+		sets[i] = WorkingSet{Reps: rx.Reps, Weight: weight}
+	}
+	return sets
+}
+
+// roundTo rounds v to the nearest multiple of increment.
+func roundTo(v, increment float64) float64 {
+	return math.Round(v/increment) * increment
+}
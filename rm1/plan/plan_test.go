@@ -0,0 +1,164 @@
+package plan
+
+import (
+	"math"
+	"testing"
+
+	"github.com/Logan-Dang/golang-1rm/rm1"
+)
+
+func approxEqual(got, want, tol float64) bool {
+	return math.Abs(got-want) <= tol
+}
+
+func TestInterpIndicesClamping(t *testing.T) {
+	axis := []float64{1, 2, 3, 4}
+
+	if lo, hi, frac := interpIndices(axis, 0); lo != 0 || hi != 0 || frac != 0 {
+		t.Errorf("interpIndices(axis, 0) = (%v, %v, %v), want (0, 0, 0)", lo, hi, frac)
+	}
+	if lo, hi, frac := interpIndices(axis, 1); lo != 0 || hi != 0 || frac != 0 {
+		t.Errorf("interpIndices(axis, 1) = (%v, %v, %v), want (0, 0, 0)", lo, hi, frac)
+	}
+	if lo, hi, frac := interpIndices(axis, 10); lo != 3 || hi != 3 || frac != 0 {
+		t.Errorf("interpIndices(axis, 10) = (%v, %v, %v), want (3, 3, 0)", lo, hi, frac)
+	}
+	if lo, hi, frac := interpIndices(axis, 4); lo != 3 || hi != 3 || frac != 0 {
+		t.Errorf("interpIndices(axis, 4) = (%v, %v, %v), want (3, 3, 0)", lo, hi, frac)
+	}
+	if lo, hi, frac := interpIndices(axis, 2.5); lo != 1 || hi != 2 || frac != 0.5 {
+		t.Errorf("interpIndices(axis, 2.5) = (%v, %v, %v), want (1, 2, 0.5)", lo, hi, frac)
+	}
+}
+
+func TestBilinear(t *testing.T) {
+	xAxis := []float64{1, 2}
+	yAxis := []float64{10, 20}
+	grid := [][]float64{
+		{0, 10},
+		{20, 30},
+	}
+
+	cases := []struct {
+		x, y float64
+		want float64
+	}{
+		{1, 10, 0},    // exact corner
+		{2, 20, 30},   // exact corner
+		{1.5, 10, 10}, // midpoint along x
+		{1, 15, 5},    // midpoint along y
+		{1.5, 15, 15}, // center of the cell
+		{-5, -5, 0},   // clamped below both axes
+		{99, 99, 30},  // clamped above both axes
+	}
+	for _, c := range cases {
+		if got := bilinear(xAxis, yAxis, grid, c.x, c.y); !approxEqual(got, c.want, 1e-9) {
+			t.Errorf("bilinear(%v, %v) = %v, want %v", c.x, c.y, got, c.want)
+		}
+	}
+}
+
+func TestLoadForRPEExactGridPoints(t *testing.T) {
+	// RPE 10 at 1 rep is defined to be 100% of 1RM.
+	if got, want := LoadForRPE(300, 1, 10), 300.0; !approxEqual(got, want, 1e-9) {
+		t.Errorf("LoadForRPE(300, 1, 10) = %v, want %v", got, want)
+	}
+	// 5 reps at RPE 8: 100 - 2.5*4 - 2.5*2 = 85%.
+	if got, want := LoadForRPE(300, 5, 8), 255.0; !approxEqual(got, want, 1e-9) {
+		t.Errorf("LoadForRPE(300, 5, 8) = %v, want %v", got, want)
+	}
+}
+
+func TestLoadForRPEClampsAtChartEdges(t *testing.T) {
+	// Beyond the last reps row, the result must clamp to the edge row
+	// rather than extrapolating further down.
+	if got, want := LoadForRPE(300, 20, 8), LoadForRPE(300, 12, 8); !approxEqual(got, want, 1e-9) {
+		t.Errorf("LoadForRPE(300, 20, 8) = %v, want it clamped to reps=12: %v", got, want)
+	}
+	// Below the first reps row, the result must clamp to the edge row.
+	if got, want := LoadForRPE(300, 0, 8), LoadForRPE(300, 1, 8); !approxEqual(got, want, 1e-9) {
+		t.Errorf("LoadForRPE(300, 0, 8) = %v, want it clamped to reps=1: %v", got, want)
+	}
+	// Above the top RPE, the result must clamp to RPE 10.
+	if got, want := LoadForRPE(300, 5, 15), LoadForRPE(300, 5, 10); !approxEqual(got, want, 1e-9) {
+		t.Errorf("LoadForRPE(300, 5, 15) = %v, want it clamped to rpe=10: %v", got, want)
+	}
+	// Below the bottom RPE, the result must clamp to RPE 6.
+	if got, want := LoadForRPE(300, 5, 0), LoadForRPE(300, 5, 6); !approxEqual(got, want, 1e-9) {
+		t.Errorf("LoadForRPE(300, 5, 0) = %v, want it clamped to rpe=6: %v", got, want)
+	}
+}
+
+func TestLoadForPercent(t *testing.T) {
+	if got, want := LoadForPercent(300, 80), 240.0; !approxEqual(got, want, 1e-9) {
+		t.Errorf("LoadForPercent(300, 80) = %v, want %v", got, want)
+	}
+}
+
+func TestRepsAtLoad(t *testing.T) {
+	got := RepsAtLoad(300, 240, rm1.Epley)
+	want := rm1.RepPredict(300, 240, rm1.Epley)
+	if got != want {
+		t.Errorf("RepsAtLoad(300, 240, Epley) = %v, want %v (rm1.RepPredict result)", got, want)
+	}
+}
+
+func TestSessionRPEPercentPrecedence(t *testing.T) {
+	scheme := []SetPrescription{
+		// Both set: RPE must take precedence over Percent.
+		{Reps: 5, RPE: 8, Percent: 50},
+	}
+	sets := Session(300, scheme, SessionOptions{})
+	want := LoadForRPE(300, 5, 8)
+	if len(sets) != 1 || !approxEqual(sets[0].Weight, want, 1e-9) {
+		t.Errorf("Session(...) = %v, want weight %v from RPE (not Percent)", sets, want)
+	}
+}
+
+func TestSessionPercentOnly(t *testing.T) {
+	scheme := []SetPrescription{{Reps: 3, Percent: 85}}
+	sets := Session(300, scheme, SessionOptions{})
+	want := LoadForPercent(300, 85)
+	if len(sets) != 1 || !approxEqual(sets[0].Weight, want, 1e-9) {
+		t.Errorf("Session(...) = %v, want weight %v from Percent", sets, want)
+	}
+}
+
+func TestSessionRoundTo(t *testing.T) {
+	scheme := []SetPrescription{{Reps: 5, Percent: 82.43}}
+	sets := Session(300, scheme, SessionOptions{RoundTo: 2.5})
+	raw := LoadForPercent(300, 82.43)
+	want := math.Round(raw/2.5) * 2.5
+	if len(sets) != 1 || sets[0].Weight != want {
+		t.Errorf("Session(..., RoundTo: 2.5) = %v, want weight rounded to %v", sets, want)
+	}
+	// The rounded weight must actually be a multiple of 2.5.
+	if remainder := math.Mod(sets[0].Weight, 2.5); math.Abs(remainder) > 1e-9 && math.Abs(remainder-2.5) > 1e-9 {
+		t.Errorf("Session(..., RoundTo: 2.5) weight = %v, not a multiple of 2.5", sets[0].Weight)
+	}
+}
+
+func TestSessionCustomChart(t *testing.T) {
+	custom := RPEChart{
+		Reps:    []int{1, 5},
+		RPEs:    []float64{8, 10},
+		Percent: [][]float64{{90, 95}, {80, 85}},
+	}
+	scheme := []SetPrescription{{Reps: 5, RPE: 9}}
+
+	sets := Session(300, scheme, SessionOptions{Chart: custom})
+	want := custom.LoadForRPE(300, 5, 9)
+	if len(sets) != 1 || !approxEqual(sets[0].Weight, want, 1e-9) {
+		t.Errorf("Session(..., Chart: custom) = %v, want weight %v from the custom chart", sets, want)
+	}
+
+	// DefaultRPEChart must be unaffected by passing a custom chart: 5 reps
+	// at RPE 9 there is still 100 - 2.5*4 - 2.5*1 = 87.5% of 1RM.
+	defaultWant := LoadForRPE(300, 5, 9)
+	if !approxEqual(defaultWant, 262.5, 1e-9) {
+		t.Errorf("LoadForRPE(300, 5, 9) = %v after Session with a custom chart, want 262.5 from DefaultRPEChart", defaultWant)
+	}
+	if approxEqual(want, defaultWant, 1e-9) {
+		t.Skip("custom and default charts happened to agree at this point; not a useful assertion")
+	}
+}
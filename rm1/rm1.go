@@ -2,10 +2,64 @@
 // using various formulas and to predict repetitions based on a given 1RM.
 package rm1
 
+import "math"
+
 const (
 	Version = "1.0.1"
 )
 
+// MaxPredictedReps caps the output of every RepPredict* function. Formulas
+// that are solved for reps can blow up or go negative far outside their
+// intended range (e.g. a 1RM only slightly above the working weight), so
+// predictions are clamped to this value. Override it to suit your own
+// sane rep-range assumptions.
+var MaxPredictedReps float64 = 100
+
+// rm1SpecialCase handles the edge-case behavior shared by every Rm1* formula:
+// NaN propagates, negative weight is undefined (NaN), and every formula
+// agrees that a single rep is performed at the 1RM itself. It returns the
+// result and true if the inputs were fully handled, or false if the caller
+// should go on to apply its own formula.
+func rm1SpecialCase(weight, reps float64) (float64, bool) {
+	if math.IsNaN(weight) || math.IsNaN(reps) {
+		return math.NaN(), true
+	}
+	if weight < 0 {
+		return math.NaN(), true
+	}
+	if reps == 1 {
+		return weight, true
+	}
+	return 0, false
+}
+
+// repPredictSpecialCase handles the edge-case behavior shared by every
+// RepPredict* function: NaN propagates, and a 1RM at or below zero
+// performs zero reps by definition.
+func repPredictSpecialCase(rm1, weight float64) (float64, bool) {
+	if math.IsNaN(rm1) || math.IsNaN(weight) {
+		return math.NaN(), true
+	}
+	if rm1 <= 0 {
+		return 0, true
+	}
+	return 0, false
+}
+
+// clampReps clamps a predicted rep count to [0, MaxPredictedReps]. Several
+// formulas (notably Mayhew and Wathan) can predict a negative rep count
+// when the given 1RM is below the working weight; that has no physical
+// meaning, so it's floored at zero.
+func clampReps(reps float64) float64 {
+	if reps < 0 {
+		return 0
+	}
+	if reps > MaxPredictedReps {
+		return MaxPredictedReps
+	}
+	return reps
+}
+
 // Rm1Formula represents the different formulas used for 1RM calculations.
 type Rm1Formula string
 
@@ -30,34 +84,72 @@ const (
 )
 
 // Rm1Epley calculates the one-rep max using the Epley formula.
+//
+// Special cases: reps == 1 returns weight; a NaN input returns NaN;
+// negative weight returns NaN.
 func Rm1Epley(weight float64, reps float64) float64 {
+	if v, ok := rm1SpecialCase(weight, reps); ok {
+		return v
+	}
 	return weight * (1 + reps/30)
 }
 
 // Rm1Brzycki calculates the one-rep max using the Brzycki formula.
+//
+// Special cases: reps == 1 returns weight; a NaN input returns NaN;
+// negative weight returns NaN; reps >= 37 drives the denominator to zero
+// or below, so +Inf is returned rather than a nonsense negative value.
 func Rm1Brzycki(weight float64, reps float64) float64 {
-	return weight / (1.0278 - 0.0278*reps)
+	if v, ok := rm1SpecialCase(weight, reps); ok {
+		return v
+	}
+	denom := 1.0278 - 0.0278*reps
+	if denom <= 0 {
+		return math.Inf(1)
+	}
+	return weight / denom
 }
 
 // Rm1Lombardi calculates the one-rep max using the Lombardi formula.
+//
+// Special cases: reps == 1 returns weight; a NaN input returns NaN;
+// negative weight returns NaN.
 func Rm1Lombardi(weight float64, reps float64) float64 {
+	if v, ok := rm1SpecialCase(weight, reps); ok {
+		return v
+	}
 	return weight * (1 + reps/40)
 }
 
 // Rm1Mayhew calculates the one-rep max using the Mayhew formula.
+//
+// Special cases: reps == 1 returns weight; a NaN input returns NaN;
+// negative weight returns NaN.
 func Rm1Mayhew(weight float64, reps float64) float64 {
+	if v, ok := rm1SpecialCase(weight, reps); ok {
+		return v
+	}
 	return weight * (100 / (52.2 + 41.9*reps/100))
 }
 
 // Rm1Wathan calculates the one-rep max using the Wathan formula.
+//
+// Special cases: reps == 1 returns weight; a NaN input returns NaN;
+// negative weight returns NaN.
 func Rm1Wathan(weight float64, reps float64) float64 {
+	if v, ok := rm1SpecialCase(weight, reps); ok {
+		return v
+	}
 	return weight * (100 / (48.8 + 53.8*reps/100))
 }
 
 // Rm1Default selects the most appropriate formula based on the number of reps.
+//
+// Special cases: reps == 1 returns weight; a NaN input returns NaN;
+// negative weight returns NaN.
 func Rm1Default(weight float64, reps float64) float64 {
-	if reps <= 1 {
-		return weight
+	if v, ok := rm1SpecialCase(weight, reps); ok {
+		return v
 	}
 	if reps <= 5 {
 		return Rm1Brzycki(weight, reps)
@@ -98,43 +190,60 @@ func Rm1(weight float64, reps float64, formula Rm1Formula) float64 {
 }
 
 // RepPredictEpley predicts repetitions based on a given 1RM using an inverse of the Epley formula.
+//
+// Special cases: a NaN input returns NaN; a 1RM at or below zero returns 0.
+// The result is clamped to [0, MaxPredictedReps].
 func RepPredictEpley(rm1 float64, weight float64) float64 {
-	if rm1 <= 0 {
-		return 0
+	if v, ok := repPredictSpecialCase(rm1, weight); ok {
+		return v
 	}
-	return 30 * (rm1/weight - 1)
+	return clampReps(30 * (rm1/weight - 1))
 }
 
 // RepPredictBrzycki predicts repetitions based on a given 1RM using an inverse of the Brzycki formula.
+//
+// Special cases: a NaN input returns NaN; a 1RM at or below zero returns 0.
+// The result is clamped to [0, MaxPredictedReps].
 func RepPredictBrzycki(rm1 float64, weight float64) float64 {
-	if rm1 <= 0 {
-		return 0
+	if v, ok := repPredictSpecialCase(rm1, weight); ok {
+		return v
 	}
-	return (1.0278 - rm1/weight) / 0.0278
+	return clampReps((1.0278 - rm1/weight) / 0.0278)
 }
 
 // RepPredictLombardi predicts repetitions based on a given 1RM using an inverse of the Lombardi formula.
+//
+// Special cases: a NaN input returns NaN; a 1RM at or below zero returns 0.
+// The result is clamped to [0, MaxPredictedReps].
 func RepPredictLombardi(rm1 float64, weight float64) float64 {
-	if rm1 <= 0 {
-		return 0
+	if v, ok := repPredictSpecialCase(rm1, weight); ok {
+		return v
 	}
-	return 40 * (rm1/weight - 1)
+	return clampReps(40 * (rm1/weight - 1))
 }
 
 // RepPredictMayhew predicts repetitions based on a given 1RM using an inverse of the Mayhew formula.
+//
+// Special cases: a NaN input returns NaN; a 1RM at or below zero returns 0.
+// The result is clamped to [0, MaxPredictedReps] — notably, a 1RM below the
+// working weight would otherwise predict a negative rep count.
 func RepPredictMayhew(rm1 float64, weight float64) float64 {
-	if rm1 <= 0 {
-		return 0
+	if v, ok := repPredictSpecialCase(rm1, weight); ok {
+		return v
 	}
-	return 100 * (52.2 - 100*weight/rm1) / 41.9
+	return clampReps(100 * (52.2 - 100*weight/rm1) / 41.9)
 }
 
 // RepPredictWathan predicts repetitions based on a given 1RM using an inverse of the Wathan formula.
+//
+// Special cases: a NaN input returns NaN; a 1RM at or below zero returns 0.
+// The result is clamped to [0, MaxPredictedReps] — notably, a 1RM below the
+// working weight would otherwise predict a negative rep count.
 func RepPredictWathan(rm1 float64, weight float64) float64 {
-	if rm1 <= 0 {
-		return 0
+	if v, ok := repPredictSpecialCase(rm1, weight); ok {
+		return v
 	}
-	return 100 * (48.8 - 100*weight/rm1) / 53.8
+	return clampReps(100 * (48.8 - 100*weight/rm1) / 53.8)
 }
 
 // RepPredictAll predicts repetitions using all formulas based on a given 1RM.
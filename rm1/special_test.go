@@ -0,0 +1,189 @@
+package rm1
+
+import (
+	"math"
+	"testing"
+)
+
+// alike reports whether x and y are equal, treating NaN as equal to NaN,
+// mirroring the helper of the same name in math/all_test.go.
+func alike(x, y float64) bool {
+	if math.IsNaN(x) && math.IsNaN(y) {
+		return true
+	}
+	return x == y
+}
+
+func TestRm1SpecialCases(t *testing.T) {
+	formulas := []struct {
+		name string
+		fn   func(weight, reps float64) float64
+	}{
+		{"Epley", Rm1Epley},
+		{"Brzycki", Rm1Brzycki},
+		{"Lombardi", Rm1Lombardi},
+		{"Mayhew", Rm1Mayhew},
+		{"Wathan", Rm1Wathan},
+		{"Default", Rm1Default},
+	}
+
+	cases := []struct {
+		name   string
+		weight float64
+		reps   float64
+		want   float64
+	}{
+		{"reps==1 returns weight", 225, 1, 225},
+		{"NaN weight propagates", math.NaN(), 5, math.NaN()},
+		{"NaN reps propagates", 225, math.NaN(), math.NaN()},
+		{"negative weight is NaN", -225, 5, math.NaN()},
+	}
+
+	for _, f := range formulas {
+		for _, c := range cases {
+			got := f.fn(c.weight, c.reps)
+			if !alike(got, c.want) {
+				t.Errorf("%s(%v, %v) = %v, want %v (%s)", f.name, c.weight, c.reps, got, c.want, c.name)
+			}
+		}
+	}
+}
+
+func TestRm1BrzyckiHighReps(t *testing.T) {
+	cases := []struct {
+		reps float64
+		want float64
+	}{
+		{36, 0}, // denominator still positive; just sanity-checked for finiteness below
+		{37, math.Inf(1)},
+		{50, math.Inf(1)},
+	}
+
+	for _, c := range cases {
+		got := Rm1Brzycki(225, c.reps)
+		if c.want == 0 {
+			if math.IsInf(got, 1) || math.IsNaN(got) {
+				t.Errorf("Rm1Brzycki(225, %v) = %v, want a finite value", c.reps, got)
+			}
+			continue
+		}
+		if !alike(got, c.want) {
+			t.Errorf("Rm1Brzycki(225, %v) = %v, want %v", c.reps, got, c.want)
+		}
+	}
+}
+
+func TestRepPredictSpecialCases(t *testing.T) {
+	predictors := []struct {
+		name string
+		fn   func(rm1, weight float64) float64
+	}{
+		{"Epley", RepPredictEpley},
+		{"Brzycki", RepPredictBrzycki},
+		{"Lombardi", RepPredictLombardi},
+		{"Mayhew", RepPredictMayhew},
+		{"Wathan", RepPredictWathan},
+	}
+
+	cases := []struct {
+		name string
+		rm1  float64
+		wt   float64
+		want float64
+	}{
+		{"rm1==0 predicts zero reps", 0, 225, 0},
+		{"negative rm1 predicts zero reps", -225, 225, 0},
+		{"NaN rm1 propagates", math.NaN(), 225, math.NaN()},
+		{"NaN weight propagates", 225, math.NaN(), math.NaN()},
+	}
+
+	for _, p := range predictors {
+		for _, c := range cases {
+			got := p.fn(c.rm1, c.wt)
+			if !alike(got, c.want) {
+				t.Errorf("%s(%v, %v) = %v, want %v (%s)", p.name, c.rm1, c.wt, got, c.want, c.name)
+			}
+		}
+	}
+}
+
+func TestRepPredictClamping(t *testing.T) {
+	// Mayhew and Wathan predict a negative rep count when rm1 is below the
+	// working weight; that must clamp to zero rather than go negative.
+	if got := RepPredictMayhew(150, 200); got != 0 {
+		t.Errorf("RepPredictMayhew(150, 200) = %v, want 0", got)
+	}
+	if got := RepPredictWathan(150, 200); got != 0 {
+		t.Errorf("RepPredictWathan(150, 200) = %v, want 0", got)
+	}
+
+	// An absurdly high rm1 relative to weight must clamp at MaxPredictedReps.
+	if got := RepPredictEpley(1e9, 100); got != MaxPredictedReps {
+		t.Errorf("RepPredictEpley(1e9, 100) = %v, want %v", got, MaxPredictedReps)
+	}
+}
+
+func TestEVariantErrors(t *testing.T) {
+	t.Run("Rm1E rejects non-positive weight", func(t *testing.T) {
+		if _, err := Rm1E(-5, 5, Epley); err != ErrInvalidWeight {
+			t.Errorf("Rm1E(-5, 5, Epley) error = %v, want %v", err, ErrInvalidWeight)
+		}
+	})
+
+	t.Run("Rm1E rejects reps below one", func(t *testing.T) {
+		if _, err := Rm1E(225, 0, Epley); err != ErrInvalidReps {
+			t.Errorf("Rm1E(225, 0, Epley) error = %v, want %v", err, ErrInvalidReps)
+		}
+	})
+
+	t.Run("Rm1E rejects non-finite input", func(t *testing.T) {
+		if _, err := Rm1E(math.NaN(), 5, Epley); err != ErrNonFinite {
+			t.Errorf("Rm1E(NaN, 5, Epley) error = %v, want %v", err, ErrNonFinite)
+		}
+		if _, err := Rm1E(225, math.Inf(1), Epley); err != ErrNonFinite {
+			t.Errorf("Rm1E(225, +Inf, Epley) error = %v, want %v", err, ErrNonFinite)
+		}
+	})
+
+	t.Run("RepPredictE rejects non-finite rm1", func(t *testing.T) {
+		if _, err := RepPredictE(math.NaN(), 100, Epley); err != ErrNonFinite {
+			t.Errorf("RepPredictE(NaN, 100, Epley) error = %v, want %v", err, ErrNonFinite)
+		}
+		if _, err := RepPredictE(math.Inf(1), 100, Epley); err != ErrNonFinite {
+			t.Errorf("RepPredictE(+Inf, 100, Epley) error = %v, want %v", err, ErrNonFinite)
+		}
+	})
+
+	t.Run("RepPredictE rejects non-positive weight", func(t *testing.T) {
+		if _, err := RepPredictE(300, -100, Epley); err != ErrInvalidWeight {
+			t.Errorf("RepPredictE(300, -100, Epley) error = %v, want %v", err, ErrInvalidWeight)
+		}
+	})
+
+	t.Run("RepPredictE allows non-positive rm1", func(t *testing.T) {
+		got, err := RepPredictE(-300, 100, Epley)
+		if err != nil {
+			t.Fatalf("RepPredictE(-300, 100, Epley) error = %v, want nil", err)
+		}
+		if got != 0 {
+			t.Errorf("RepPredictE(-300, 100, Epley) = %v, want 0", got)
+		}
+	})
+
+	for _, fn := range []struct {
+		name string
+		call func() (float64, error)
+	}{
+		{"RepPredictEpleyE", func() (float64, error) { return RepPredictEpleyE(math.NaN(), 100) }},
+		{"RepPredictBrzyckiE", func() (float64, error) { return RepPredictBrzyckiE(math.Inf(1), 100) }},
+		{"RepPredictLombardiE", func() (float64, error) { return RepPredictLombardiE(math.NaN(), 100) }},
+		{"RepPredictMayhewE", func() (float64, error) { return RepPredictMayhewE(math.Inf(-1), 100) }},
+		{"RepPredictWathanE", func() (float64, error) { return RepPredictWathanE(math.NaN(), 100) }},
+	} {
+		t.Run(fn.name+" rejects non-finite rm1", func(t *testing.T) {
+			if _, err := fn.call(); err != ErrNonFinite {
+				t.Errorf("%s = error %v, want %v", fn.name, err, ErrNonFinite)
+			}
+		})
+	}
+}